@@ -2,9 +2,14 @@
 # Auto-format hook for Claude Code
 # Triggers on Write|Edit|MultiEdit|NotebookEdit|Update
 # Runs appropriate formatter based on file extension
+# Go files are also vetted; diagnostics go to stderr (exit 2) for Claude to fix
 
 set -euo pipefail
 
+# Upper bound (seconds) for go list / go vet; a cold module cache can make
+# them download dependencies, and this hook blocks the agent while it runs
+GO_TIMEOUT="${GO_TIMEOUT:-20}"
+
 # Parse JSON input from stdin
 input=$(cat)
 file_path=$(echo "$input" | jq -r '.tool_input.file_path // .tool_response.filePath // empty')
@@ -37,11 +42,53 @@ case "$ext" in
     ;;
 
   go)
-    # Go: gofmt (built-in, always available)
-    gofmt -w "$file_path" 2>&1
-    exit_code=$?
-    if [[ $exit_code -ne 0 ]]; then
-      echo "gofmt formatting failed for $file_path"
+    # Go: goimports first (if installed) so the formatter also formats
+    # any imports it adds
+    if command -v goimports &> /dev/null; then
+      if ! output=$(goimports -w "$file_path" 2>&1); then
+        echo "goimports failed for $file_path:" >&2
+        echo "$output" >&2
+        exit 2
+      fi
+    fi
+
+    # gofumpt if installed, otherwise gofmt (built-in, always available)
+    formatter=gofmt
+    command -v gofumpt &> /dev/null && formatter=gofumpt
+    if ! output=$("$formatter" -w "$file_path" 2>&1); then
+      echo "$formatter formatting failed for $file_path:" >&2
+      echo "$output" >&2
+      exit 2
+    fi
+
+    # Vet just the edited file's package, and only inside a module.
+    # testdata/ is never built, so there is nothing to vet.
+    [[ "$file_path" == */testdata/* ]] && exit 0
+    pkg_dir=$(dirname "$file_path")
+    gomod=$(cd "$pkg_dir" && go env GOMOD 2>/dev/null || true)
+    [[ -z "$gomod" || "$gomod" == /dev/null ]] && exit 0
+
+    # Skip packages with no buildable files (e.g. all //go:build ignore);
+    # vet fails on those and the agent can't fix that
+    go_status=0
+    file_counts=$(cd "$pkg_dir" && timeout "$GO_TIMEOUT" go list -e \
+      -f '{{len .GoFiles}}{{len .TestGoFiles}}{{len .XTestGoFiles}}' . 2>/dev/null) || go_status=$?
+    if [[ $go_status -eq 124 ]]; then
+      echo "go list timed out after ${GO_TIMEOUT}s in $pkg_dir, skipped go vet" >&2
+      exit 0
+    elif [[ $go_status -ne 0 ]]; then
+      echo "go list failed (exit $go_status) in $pkg_dir, skipped go vet" >&2
+      exit 0
+    fi
+    [[ "$file_counts" != *[1-9]* ]] && exit 0
+
+    go_status=0
+    output=$(cd "$pkg_dir" && timeout "$GO_TIMEOUT" go vet . 2>&1) || go_status=$?
+    if [[ $go_status -eq 124 ]]; then
+      echo "go vet timed out after ${GO_TIMEOUT}s in $pkg_dir, skipped" >&2
+    elif [[ $go_status -ne 0 ]]; then
+      echo "go vet reported issues in $pkg_dir:" >&2
+      echo "$output" >&2
       exit 2
     fi
     ;;
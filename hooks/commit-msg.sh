@@ -0,0 +1,65 @@
+#!/usr/bin/env bash
+# Commit-msg hook: enforces conventional-commit format on the subject line
+# Install as .git/hooks/commit-msg; git passes the message file as $1
+#
+# Per-repo settings (git config):
+#   hooks.commitmsg.maxSubjectLength  subject length limit (default 72)
+#   hooks.commitmsg.requireIssue      require an issue reference (default false)
+#   hooks.commitmsg.issuePattern      ERE for an issue reference, matched as a
+#                                     whole word (default '#[0-9]+'); set it to
+#                                     the project key for JIRA, e.g. 'PROJ-[0-9]+'
+
+set -euo pipefail
+
+# Colors for output (disabled when not a TTY or NO_COLOR is set)
+if [[ -t 1 && -z "${NO_COLOR:-}" ]]; then
+    RED='\033[0;31m'
+    YELLOW='\033[1;33m'
+    NC='\033[0m' # No Color
+else
+    RED='' YELLOW='' NC=''
+fi
+
+MAX_SUBJECT_LENGTH=$(git config --type=int --get hooks.commitmsg.maxSubjectLength || echo 72)
+REQUIRE_ISSUE=$(git config --type=bool --get hooks.commitmsg.requireIssue || echo false)
+ISSUE_PATTERN=$(git config --get hooks.commitmsg.issuePattern || echo '#[0-9]+')
+COMMIT_TYPES='feat|fix|perf|refactor|docs|test|build|ci|chore|style|revert'
+SUBJECT_PATTERN="^(${COMMIT_TYPES})(\([a-z0-9._/-]+\))?!?: [^ ].*"
+
+msg_file="$1"
+
+# Drop comment lines and everything below the verbose-mode scissors line
+message=$(sed -e '/^# -\{8,\} >8 -\{8,\}$/,$d' -e '/^#/d' "$msg_file")
+subject=$(head -n 1 <<< "$message")
+
+# Leave git-generated messages alone
+if [[ "$subject" =~ ^(Merge|Revert\ \"|fixup!|squash!|amend!) ]]; then
+    exit 0
+fi
+
+errors=()
+
+if [[ ! "$subject" =~ $SUBJECT_PATTERN ]]; then
+    errors+=("Subject must look like 'type(scope): summary' with type one of: ${COMMIT_TYPES//|/, }")
+fi
+
+if [[ ${#subject} -gt $MAX_SUBJECT_LENGTH ]]; then
+    errors+=("Subject is ${#subject} characters (max $MAX_SUBJECT_LENGTH)")
+fi
+
+# Whole-word match, so e.g. 'PROJ-[0-9]+' doesn't accept 'XPROJ-1' or 'PROJ-1a'
+if [[ "$REQUIRE_ISSUE" == true ]] \
+    && ! grep -qE "(^|[^[:alnum:]_])(${ISSUE_PATTERN})($|[^[:alnum:]_])" <<< "$message"; then
+    errors+=("Message must reference an issue matching '$ISSUE_PATTERN'")
+fi
+
+if [[ ${#errors[@]} -eq 0 ]]; then
+    exit 0
+fi
+
+echo -e "${RED}❌ Commit message rejected:${NC}"
+for error in "${errors[@]}"; do
+    echo -e "    ${RED}✗${NC} $error"
+done
+echo -e "    ${YELLOW}⚠${NC} Subject was: $subject"
+exit 1
@@ -4,11 +4,45 @@
 
 set -euo pipefail
 
-# Colors for output
-RED='\033[0;31m'
-GREEN='\033[0;32m'
-YELLOW='\033[1;33m'
-NC='\033[0m' # No Color
+# Colors for output (disabled when not a TTY or NO_COLOR is set)
+if [[ -t 1 && -z "${NO_COLOR:-}" ]]; then
+    RED='\033[0;31m'
+    GREEN='\033[0;32m'
+    YELLOW='\033[1;33m'
+    NC='\033[0m' # No Color
+else
+    RED='' GREEN='' YELLOW='' NC=''
+fi
+
+# Runs a command and prints its combined output, showing a spinner on
+# interactive terminals. The output is also kept in $step_output.
+step_output=""
+run_step() {
+    local log pid="" status=0 frames='|/-\' i=0
+    log=$(mktemp)
+
+    # Background jobs ignore SIGINT, so stop the step and clean up ourselves
+    trap 'kill "$pid" 2> /dev/null; rm -f "$log"; exit 130' INT TERM
+
+    if [[ -t 1 ]]; then
+        "$@" > "$log" 2>&1 &
+        pid=$!
+        while kill -0 "$pid" 2> /dev/null; do
+            printf '\r    %s' "${frames:i++%4:1}"
+            sleep 0.1
+        done
+        wait "$pid" || status=$?
+        printf '\r\033[K'
+        cat "$log"
+    else
+        "$@" 2>&1 | tee "$log" || status=${PIPESTATUS[0]}
+    fi
+
+    trap - INT TERM
+    step_output=$(< "$log")
+    rm -f "$log"
+    return "$status"
+}
 
 echo -e "${GREEN}🔍 Running pre-commit validation...${NC}"
 
@@ -24,6 +58,7 @@ fi
 overall_status=0
 
 # Group files by language
+all_files=()
 python_files=()
 js_files=()
 go_files=()
@@ -33,6 +68,7 @@ while IFS= read -r file; do
         continue  # Skip deleted files
     fi
 
+    all_files+=("$file")
     ext="${file##*.}"
     case "$ext" in
         py)
@@ -47,13 +83,140 @@ while IFS= read -r file; do
     esac
 done <<< "$staged_files"
 
+# Secret scanning (all staged files, any language)
+# Known false positives go in .secrets-baseline, one fingerprint per line
+SECRETS_BASELINE=".secrets-baseline"
+# Shannon entropy limits (bits per char); hex tops out at 4.0, so it gets its own
+BASE64_ENTROPY_THRESHOLD=4.5
+HEX_ENTROPY_THRESHOLD=3.0
+
+# Rule name -> pattern. Patterns with a leading boundary group capture one
+# extra character, which find_secrets strips before printing.
+declare -A SECRET_PATTERNS=(
+    [aws-key]='AKIA[0-9A-Z]{16}'
+    [github-token]='gh[pousr]_[A-Za-z0-9]{36}'
+    [gitlab-token]='glpat-[A-Za-z0-9_-]{20}'
+    [slack-token]='xox[abprs]-[A-Za-z0-9-]{10,}'
+    [google-api-key]='AIza[0-9A-Za-z_-]{35}'
+    [api-key]='(^|[^A-Za-z0-9_-])sk-((proj|ant-api[0-9]+)-[A-Za-z0-9_-]{20,}|[A-Za-z0-9]{20,})'
+    [private-key]='-----BEGIN [A-Z ]*PRIVATE KEY-----'
+)
+
+# Lockfiles and checksum files are full of high-entropy hashes
+is_checksum_file() {
+    case "$(basename "$1")" in
+        go.sum|*.sum|package-lock.json|yarn.lock|pnpm-lock.yaml) return 0 ;;
+    esac
+    return 1
+}
+
+# Prints "line:rule:match" for each suspected secret in the staged blob of $1
+find_secrets() {
+    local file="$1"
+    local rule
+
+    # Binary blobs show up as "-<TAB>-" in numstat
+    [[ "$(git diff --cached --numstat -- "$file")" == -$'\t'-* ]] && return 0
+
+    for rule in "${!SECRET_PATTERNS[@]}"; do
+        git show ":$file" | grep -noE -e "${SECRET_PATTERNS[$rule]}" \
+            | sed -E "s/^([0-9]+):[^A-Za-z0-9_-]?/\1:$rule:/" || true
+    done
+
+    is_checksum_file "$file" && return 0
+
+    # Long base64/hex tokens with high Shannon entropy, ignoring go.sum (h1:),
+    # subresource integrity (sha512-) and image digest (sha256:) hashes.
+    # "=" only counts as trailing padding, so KEY=value yields just the value.
+    git show ":$file" | grep -noE '(h1:|sha(1|256|384|512)[:-])?[A-Za-z0-9+/_-]{32,}={0,2}' \
+        | awk -v b64_limit="$BASE64_ENTROPY_THRESHOLD" -v hex_limit="$HEX_ENTROPY_THRESHOLD" '
+    function entropy(s,    n, i, c, h, p, counts) {
+        n = length(s); split("", counts)
+        for (i = 1; i <= n; i++) counts[substr(s, i, 1)]++
+        h = 0
+        for (c in counts) { p = counts[c] / n; h -= p * log(p) / log(2) }
+        return h
+    }
+    function is_uuid(s) {
+        return length(s) == 36 && s ~ /^[0-9A-Fa-f-]+$/ && substr(s, 9, 1) == "-" \
+            && substr(s, 14, 1) == "-" && substr(s, 19, 1) == "-" && substr(s, 24, 1) == "-"
+    }
+    function check(s) {
+        if (length(s) < 32 || is_uuid(s)) return
+        if (s ~ /^[0-9A-Fa-f]+$/) {
+            if (entropy(s) > hex_limit) print line ":high-entropy:" s
+        } else if (entropy(s) > b64_limit) {
+            print line ":high-entropy:" s
+        }
+    }
+    {
+        sep = index($0, ":"); line = substr($0, 1, sep - 1); tok = substr($0, sep + 1)
+        if (tok ~ /^(h1:|sha(1|256|384|512)[:-])/) next
+
+        # Paths (two or more "/"-separated segments) are scored per segment
+        n = split(tok, segments, "/"); parts = 0
+        for (i = 1; i <= n; i++) if (segments[i] != "") parts++
+        if (parts < 2) { check(tok); next }
+        for (i = 1; i <= n; i++) check(segments[i])
+    }' || true
+}
+
+secret_fingerprint() {
+    printf '%s:%s' "$1" "$2" | sha256sum | cut -c1-16
+}
+
+is_baselined() {
+    [[ -f "$SECRETS_BASELINE" ]] && grep -q "^$1" "$SECRETS_BASELINE"
+}
+
+echo -e "\n${GREEN}🔐 Scanning for secrets (${#all_files[@]} files)${NC}"
+secrets_found=0
+
+for file in "${all_files[@]}"; do
+    [[ "$file" == "$SECRETS_BASELINE" ]] && continue
+
+    # .env files should never be committed (templates are fine)
+    name=$(basename "$file")
+    if [[ "$name" == .env || "$name" == .env.* ]] && [[ ! "$name" =~ \.(example|sample|template)$ ]]; then
+        fp=$(secret_fingerprint "$file" "$name")
+        if ! is_baselined "$fp"; then
+            echo -e "    ${RED}✗${NC} $file: environment file staged [$fp]"
+            secrets_found=1
+        fi
+        continue
+    fi
+
+    # find_secrets emits named rules before high-entropy and the sort is
+    # stable, so entropy hits on a line a named rule already flagged are dropped
+    declare -A named_lines=()
+    while IFS=: read -r line rule match; do
+        if [[ "$rule" == high-entropy ]]; then
+            [[ -n "${named_lines[$line]:-}" ]] && continue
+        else
+            named_lines[$line]=1
+        fi
+        fp=$(secret_fingerprint "$file" "$match")
+        if ! is_baselined "$fp"; then
+            echo -e "    ${RED}✗${NC} $file:$line: possible secret ($rule) [$fp]"
+            secrets_found=1
+        fi
+    done < <(find_secrets "$file" | sort -s -t: -k1,1n)
+done
+
+if [[ $secrets_found -eq 0 ]]; then
+    echo -e "    ${GREEN}✓${NC} No secrets found"
+else
+    echo -e "    ${YELLOW}⚠${NC} Add the [fingerprint] to $SECRETS_BASELINE if this is a false positive"
+    overall_status=1
+fi
+
 # Python validation
 if [[ ${#python_files[@]} -gt 0 ]]; then
     echo -e "\n${GREEN}📝 Python files (${#python_files[@]} files)${NC}"
 
     # Step 1: Auto-format with ruff
     echo "  • Formatting with ruff..."
-    if ruff format --config ~/.claude/configs/python/ruff.toml "${python_files[@]}" 2>&1; then
+    if run_step ruff format --config ~/.claude/configs/python/ruff.toml "${python_files[@]}"; then
         echo -e "    ${GREEN}✓${NC} Formatting complete"
         # Re-stage formatted files
         git add "${python_files[@]}"
@@ -64,7 +227,7 @@ if [[ ${#python_files[@]} -gt 0 ]]; then
 
     # Step 2: Lint with ruff check
     echo "  • Linting with ruff check..."
-    if ruff check --config ~/.claude/configs/python/ruff.toml "${python_files[@]}" 2>&1; then
+    if run_step ruff check --config ~/.claude/configs/python/ruff.toml "${python_files[@]}"; then
         echo -e "    ${GREEN}✓${NC} Linting passed"
     else
         echo -e "    ${RED}✗${NC} Linting failed - fix errors above"
@@ -73,13 +236,12 @@ if [[ ${#python_files[@]} -gt 0 ]]; then
 
     # Step 3: Type check with pyright
     echo "  • Type checking with pyright..."
-    if pyright "${python_files[@]}" 2>&1 | grep -v "0 errors, 0 warnings" > /dev/null; then
-        # Has errors/warnings
-        pyright "${python_files[@]}" 2>&1
+    # pyright exits 0 on warnings, so check its summary too
+    if run_step pyright "${python_files[@]}" && [[ "$step_output" == *"0 errors, 0 warnings"* ]]; then
+        echo -e "    ${GREEN}✓${NC} Type checking passed"
+    else
         echo -e "    ${RED}✗${NC} Type checking failed - fix errors above"
         overall_status=1
-    else
-        echo -e "    ${GREEN}✓${NC} Type checking passed"
     fi
 fi
 
@@ -89,7 +251,7 @@ if [[ ${#js_files[@]} -gt 0 ]]; then
 
     # Step 1: Auto-format with prettier
     echo "  • Formatting with prettier..."
-    if prettier --config ~/.claude/configs/javascript/prettier.json --write "${js_files[@]}" 2>&1; then
+    if run_step prettier --config ~/.claude/configs/javascript/prettier.json --write "${js_files[@]}"; then
         echo -e "    ${GREEN}✓${NC} Formatting complete"
         # Re-stage formatted files
         git add "${js_files[@]}"
@@ -101,7 +263,7 @@ if [[ ${#js_files[@]} -gt 0 ]]; then
     # Step 2: Lint with eslint
     echo "  • Linting with eslint..."
     if command -v eslint &> /dev/null; then
-        if eslint --config ~/.claude/configs/javascript/.eslintrc.json "${js_files[@]}" 2>&1; then
+        if run_step eslint --config ~/.claude/configs/javascript/.eslintrc.json "${js_files[@]}"; then
             echo -e "    ${GREEN}✓${NC} Linting passed"
         else
             echo -e "    ${RED}✗${NC} Linting failed - fix errors above"
@@ -122,7 +284,7 @@ if [[ ${#js_files[@]} -gt 0 ]]; then
     if [[ ${#ts_files[@]} -gt 0 ]]; then
         echo "  • Type checking with tsc..."
         if command -v tsc &> /dev/null; then
-            if tsc --noEmit "${ts_files[@]}" 2>&1; then
+            if run_step tsc --noEmit "${ts_files[@]}"; then
                 echo -e "    ${GREEN}✓${NC} Type checking passed"
             else
                 echo -e "    ${RED}✗${NC} Type checking failed - fix errors above"
@@ -152,7 +314,7 @@ if [[ ${#go_files[@]} -gt 0 ]]; then
     # Step 2: Lint with golangci-lint
     echo "  • Linting with golangci-lint..."
     if command -v golangci-lint &> /dev/null; then
-        if golangci-lint run "${go_files[@]}" 2>&1; then
+        if run_step golangci-lint run "${go_files[@]}"; then
             echo -e "    ${GREEN}✓${NC} Linting passed"
         else
             echo -e "    ${RED}✗${NC} Linting failed - fix errors above"